@@ -0,0 +1,217 @@
+package travis
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RetryPolicy governs how Batch retries a failed per-item request
+// before giving up on it.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of tries per item, including the
+	// first. A value <= 1 disables retries.
+	MaxAttempts int
+
+	// Backoff spaces out retries for a given item.
+	Backoff Backoff
+}
+
+// BatchOptions specifies the optional parameters to NewBatch.
+type BatchOptions struct {
+	// Concurrency bounds the number of in-flight requests. Defaults to
+	// 1 (serial) when <= 0.
+	Concurrency int
+
+	// RateLimit caps the number of requests issued per second across
+	// the whole batch, independently of Concurrency. Unlimited when
+	// <= 0.
+	RateLimit float64
+
+	// RetryPolicy governs per-item retries of transient failures.
+	RetryPolicy RetryPolicy
+
+	// StopOnError cancels remaining work as soon as one item fails,
+	// once any retries for that item are used up, instead of draining
+	// the batch and returning partial results.
+	StopOnError bool
+}
+
+// Batch runs Cancel/Restart operations against many jobs or builds
+// concurrently, through a bounded worker pool that respects an optional
+// rate limit.
+type Batch struct {
+	client  *Client
+	opts    BatchOptions
+	limiter *rate.Limiter
+}
+
+// NewBatch returns a Batch that issues requests through client according
+// to opts.
+func NewBatch(client *Client, opts BatchOptions) *Batch {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+
+	var limiter *rate.Limiter
+	if opts.RateLimit > 0 {
+		limiter = rate.NewLimiter(rate.Limit(opts.RateLimit), 1)
+	}
+
+	return &Batch{client: client, opts: opts, limiter: limiter}
+}
+
+// BatchItemResult reports the outcome of a single item within a
+// BatchResult.
+type BatchItemResult struct {
+	ID  uint
+	Err error
+}
+
+// BatchResult reports the per-ID outcome of a Batch operation.
+type BatchResult struct {
+	// Results is indexed in the same order as the ids passed to the
+	// Batch method that produced it.
+	Results []BatchItemResult
+
+	// Elapsed is the wall-clock time the operation took to drain.
+	Elapsed time.Duration
+}
+
+// Failed returns the IDs that did not succeed.
+func (r BatchResult) Failed() []uint {
+	var ids []uint
+	for _, res := range r.Results {
+		if res.Err != nil {
+			ids = append(ids, res.ID)
+		}
+	}
+
+	return ids
+}
+
+// run executes op for every id in ids through the bounded worker pool,
+// honoring Concurrency, RateLimit, RetryPolicy and StopOnError. It
+// drains cleanly and returns partial results if ctx is canceled.
+func (b *Batch) run(ctx context.Context, ids []uint, op func(context.Context, uint) error) BatchResult {
+	start := time.Now()
+
+	results := make([]BatchItemResult, len(ids))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := make(chan struct{}, b.opts.Concurrency)
+	var wg sync.WaitGroup
+
+	for i, id := range ids {
+		if ctx.Err() != nil {
+			results[i] = BatchItemResult{ID: id, Err: ctx.Err()}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			results[i] = BatchItemResult{ID: id, Err: ctx.Err()}
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, id uint) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := b.attempt(ctx, id, op)
+			results[i] = BatchItemResult{ID: id, Err: err}
+
+			if err != nil && b.opts.StopOnError {
+				cancel()
+			}
+		}(i, id)
+	}
+
+	wg.Wait()
+
+	return BatchResult{Results: results, Elapsed: time.Since(start)}
+}
+
+// attempt runs op against id, retrying according to b.opts.RetryPolicy
+// on transient failures.
+func (b *Batch) attempt(ctx context.Context, id uint, op func(context.Context, uint) error) error {
+	maxAttempts := b.opts.RetryPolicy.MaxAttempts
+	if maxAttempts <= 1 {
+		maxAttempts = 1
+	}
+
+	var err error
+	for try := 0; try < maxAttempts; try++ {
+		if b.limiter != nil {
+			if waitErr := b.limiter.Wait(ctx); waitErr != nil {
+				return waitErr
+			}
+		}
+
+		err = op(ctx, id)
+		if err == nil {
+			return nil
+		}
+
+		if !isRetryableErr(err) || try == maxAttempts-1 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(b.opts.RetryPolicy.Backoff.next(try)):
+		}
+	}
+
+	return err
+}
+
+// isRetryableErr reports whether err is worth another attempt: an
+// *APIError with a 5xx or 429 status, or any other error (network
+// timeouts, connection resets) that isn't a non-retryable *APIError.
+func isRetryableErr(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return true
+	}
+
+	return apiErr.StatusCode >= 500 || apiErr.StatusCode == 429
+}
+
+// CancelJobs cancels the jobs identified by ids, up to Concurrency at a
+// time.
+func (b *Batch) CancelJobs(ctx context.Context, ids []uint) BatchResult {
+	return b.run(ctx, ids, func(ctx context.Context, id uint) error {
+		_, err := b.client.Jobs.Cancel(ctx, id)
+		return err
+	})
+}
+
+// RestartJobs restarts the jobs identified by ids, up to Concurrency at
+// a time.
+func (b *Batch) RestartJobs(ctx context.Context, ids []uint) BatchResult {
+	return b.run(ctx, ids, func(ctx context.Context, id uint) error {
+		_, err := b.client.Jobs.Restart(ctx, id)
+		return err
+	})
+}
+
+// CancelBuilds cancels the builds identified by ids, up to Concurrency
+// at a time.
+func (b *Batch) CancelBuilds(ctx context.Context, ids []uint) BatchResult {
+	return b.run(ctx, ids, func(ctx context.Context, id uint) error {
+		resp, err := b.client.Builds.Cancel(ctx, id)
+		if err != nil {
+			return classifyError(resp, err)
+		}
+		return nil
+	})
+}
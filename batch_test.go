@@ -0,0 +1,183 @@
+package travis
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBackoff_Next(t *testing.T) {
+	b := Backoff{Initial: time.Second, Max: 10 * time.Second, Multiplier: 2}
+
+	if got := b.next(0); got != time.Second {
+		t.Errorf("attempt 0: got %s, want %s", got, time.Second)
+	}
+
+	if got := b.next(1); got != 2*time.Second {
+		t.Errorf("attempt 1: got %s, want %s", got, 2*time.Second)
+	}
+
+	if got := b.next(10); got != 10*time.Second {
+		t.Errorf("attempt 10: got %s, want capped at %s", got, 10*time.Second)
+	}
+}
+
+func TestBatchResult_Failed(t *testing.T) {
+	result := BatchResult{
+		Results: []BatchItemResult{
+			{ID: 1, Err: nil},
+			{ID: 2, Err: ErrJobNotCancelable},
+			{ID: 3, Err: nil},
+			{ID: 4, Err: ErrJobNotFound},
+		},
+	}
+
+	failed := result.Failed()
+	if len(failed) != 2 || failed[0] != 2 || failed[1] != 4 {
+		t.Errorf("unexpected failed ids: got %v, want [2 4]", failed)
+	}
+}
+
+func TestBatch_Run_RespectsConcurrency(t *testing.T) {
+	b := NewBatch(nil, BatchOptions{Concurrency: 3})
+
+	var current, peak int32
+	ids := make([]uint, 20)
+	for i := range ids {
+		ids[i] = uint(i)
+	}
+
+	result := b.run(context.Background(), ids, func(ctx context.Context, id uint) error {
+		n := atomic.AddInt32(&current, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&current, -1)
+		return nil
+	})
+
+	if got := atomic.LoadInt32(&peak); got > 3 {
+		t.Errorf("peak concurrency = %d, want <= 3", got)
+	}
+
+	if len(result.Failed()) != 0 {
+		t.Errorf("unexpected failures: %v", result.Failed())
+	}
+}
+
+func TestBatch_Run_RetriesTransientFailures(t *testing.T) {
+	b := NewBatch(nil, BatchOptions{
+		Concurrency: 1,
+		RetryPolicy: RetryPolicy{
+			MaxAttempts: 3,
+			Backoff:     Backoff{Initial: time.Millisecond, Multiplier: 1},
+		},
+	})
+
+	var mu sync.Mutex
+	attempts := map[uint]int{}
+
+	result := b.run(context.Background(), []uint{1}, func(ctx context.Context, id uint) error {
+		mu.Lock()
+		attempts[id]++
+		n := attempts[id]
+		mu.Unlock()
+
+		if n < 3 {
+			return &APIError{StatusCode: http.StatusServiceUnavailable}
+		}
+		return nil
+	})
+
+	if result.Results[0].Err != nil {
+		t.Errorf("expected eventual success, got: %s", result.Results[0].Err)
+	}
+
+	if attempts[1] != 3 {
+		t.Errorf("expected 3 attempts before success, got %d", attempts[1])
+	}
+}
+
+func TestBatch_Run_DoesNotRetryNonRetryableErrors(t *testing.T) {
+	b := NewBatch(nil, BatchOptions{
+		Concurrency: 1,
+		RetryPolicy: RetryPolicy{MaxAttempts: 5},
+	})
+
+	var calls int32
+
+	result := b.run(context.Background(), []uint{1}, func(ctx context.Context, id uint) error {
+		atomic.AddInt32(&calls, 1)
+		return &APIError{StatusCode: http.StatusUnprocessableEntity, Message: "job already finished"}
+	})
+
+	if result.Results[0].Err == nil {
+		t.Fatalf("expected a failure, got none")
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected a non-retryable error to be attempted exactly once, got %d calls", got)
+	}
+}
+
+func TestBatch_Run_StopOnErrorCancelsRemainingItems(t *testing.T) {
+	b := NewBatch(nil, BatchOptions{Concurrency: 1, StopOnError: true})
+
+	ids := []uint{1, 2, 3, 4}
+
+	result := b.run(context.Background(), ids, func(ctx context.Context, id uint) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if id == 1 {
+			return &APIError{StatusCode: http.StatusUnprocessableEntity}
+		}
+
+		return nil
+	})
+
+	for i, res := range result.Results {
+		if res.Err == nil {
+			t.Errorf("expected item %d (id %d) to fail once StopOnError triggered, got nil error", i, res.ID)
+		}
+	}
+}
+
+func TestBatch_Run_DrainsPartiallyOnContextCancel(t *testing.T) {
+	b := NewBatch(nil, BatchOptions{Concurrency: 2})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ids := []uint{1, 2, 3, 4, 5}
+	done := make(chan BatchResult, 1)
+
+	go func() {
+		done <- b.run(ctx, ids, func(ctx context.Context, id uint) error {
+			if id == 1 {
+				cancel()
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		})
+	}()
+
+	select {
+	case result := <-done:
+		if len(result.Results) != len(ids) {
+			t.Fatalf("expected a result for every id, got %d", len(result.Results))
+		}
+		if len(result.Failed()) == 0 {
+			t.Errorf("expected at least one item to carry the cancellation error")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Batch.run did not drain after context cancellation")
+	}
+}
@@ -0,0 +1,71 @@
+package travis
+
+import (
+	"context"
+	"net/http"
+)
+
+// BuildEvent is sent on the channel returned by BuildsService.Watch
+// every time the observed build is polled. Err is set, and the channel
+// closed, if polling failed non-transiently or ctx was canceled.
+type BuildEvent struct {
+	Build *Build
+	Err   error
+}
+
+// Wait blocks until the build with the provided id reaches a terminal
+// state, returning the final Build. It polls BuildsService.Get on the
+// interval described by opts, tolerating transient HTTP 5xx/429
+// responses. If ctx is canceled or its deadline exceeded, Wait returns
+// ctx.Err() rather than reporting the build as failed.
+//
+// Travis CI API docs: http://docs.travis-ci.com/api/#builds
+func (bs *BuildsService) Wait(ctx context.Context, id uint, opts *WaitOptions) (*Build, error) {
+	events, err := bs.Watch(ctx, id, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var last *Build
+	for event := range events {
+		if event.Err != nil {
+			return nil, event.Err
+		}
+		last = event.Build
+	}
+
+	return last, nil
+}
+
+// Watch polls the build with the provided id on the interval described
+// by opts, emitting a BuildEvent after every poll, and closes the
+// returned channel once the build reaches a terminal state. Transient
+// HTTP 5xx/429 responses are retried rather than surfaced as errors. If
+// ctx is canceled or its deadline exceeded, the final BuildEvent carries
+// ctx.Err().
+//
+// Travis CI API docs: http://docs.travis-ci.com/api/#builds
+func (bs *BuildsService) Watch(ctx context.Context, id uint, opts *WaitOptions) (<-chan BuildEvent, error) {
+	results := pollUntilTerminal(ctx, opts, func(ctx context.Context) (interface{}, string, *http.Response, error) {
+		build, resp, err := bs.Get(ctx, id)
+		if err != nil {
+			return nil, "", resp, err
+		}
+		return build, build.State, resp, nil
+	})
+
+	ch := make(chan BuildEvent)
+
+	go func() {
+		defer close(ch)
+		for result := range results {
+			if result.err != nil {
+				ch <- BuildEvent{Err: result.err}
+				continue
+			}
+			ch <- BuildEvent{Build: result.item.(*Build)}
+		}
+	}()
+
+	return ch, nil
+}
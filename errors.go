@@ -0,0 +1,127 @@
+package travis
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+// Sentinel errors returned by JobsService.Get, Cancel and Restart, and
+// by Batch (which applies the same classification to the BuildsService
+// calls it makes on their behalf). BuildsService and RequestsService
+// methods called directly do not yet classify their errors this way.
+// Callers should prefer errors.Is over matching on error strings, e.g.:
+//
+//	if _, err := client.Jobs.Cancel(ctx, id); errors.Is(err, travis.ErrJobNotCancelable) {
+//		// ...
+//	}
+var (
+	ErrJobNotFound        = fmt.Errorf("travis: job not found")
+	ErrJobNotCancelable   = fmt.Errorf("travis: job not cancelable")
+	ErrJobAlreadyFinished = fmt.Errorf("travis: job already finished")
+	ErrUnauthorized       = fmt.Errorf("travis: unauthorized")
+	ErrRateLimited        = fmt.Errorf("travis: rate limited")
+)
+
+// notCancelableMessage matches the server-provided error message for a
+// job or build that was refused cancellation outright, independently of
+// the error_type Travis reports for it. It deliberately excludes
+// "already finished" phrasing, which routes to ErrJobAlreadyFinished
+// instead via alreadyFinishedMessage.
+var notCancelableMessage = regexp.MustCompile(`(?i)(not cancelable|can('|’)t be canceled)`)
+
+// alreadyFinishedMessage matches the server-provided error message for a
+// job or build that can no longer be acted on because it already
+// reached a terminal state, independently of the error_type Travis
+// reports for it.
+var alreadyFinishedMessage = regexp.MustCompile(`(?i)(already finished|already (completed|done))`)
+
+// errorResponse mirrors the JSON error envelope returned by the Travis
+// CI API, e.g. {"@type":"error","error_type":"not_found","error_message":"job not found"}.
+type errorResponse struct {
+	ErrorType    string `json:"error_type"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// APIError represents an error reported by the Travis CI API. Through
+// its Is method it matches the sentinel errors above when its status,
+// type or message says so, and carries the details either way. StatusCode, ErrorType and
+// Message come from the response; RequestID is read from the
+// X-Request-Id response header, when present.
+type APIError struct {
+	StatusCode int
+	ErrorType  string
+	Message    string
+	RequestID  string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("travis: %s (status %d, request %s): %s", e.ErrorType, e.StatusCode, e.RequestID, e.Message)
+}
+
+// Is allows errors.Is(err, travis.ErrJobNotFound) and similar checks to
+// succeed against an *APIError without requiring Client.Do to construct
+// the sentinel errors directly.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrJobNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrJobNotCancelable:
+		return e.ErrorType == "not_cancelable" || notCancelableMessage.MatchString(e.Message)
+	case ErrJobAlreadyFinished:
+		return e.ErrorType == "already_finished" || alreadyFinishedMessage.MatchString(e.Message)
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+
+	return false
+}
+
+// newAPIError builds an *APIError from a non-2xx response, parsing
+// Travis's JSON error body when present. Ideally Client.Do (defined
+// outside this chunk) would call this directly with the raw response
+// body so ErrorType is always populated; until then, classifyError
+// below calls it with the error text Client.Do already produced so
+// Get/Cancel/Restart still return a real, errors.Is-comparable
+// *APIError.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}
+
+	var errResp errorResponse
+	if err := json.Unmarshal(body, &errResp); err == nil {
+		apiErr.ErrorType = errResp.ErrorType
+		apiErr.Message = errResp.ErrorMessage
+	} else if len(body) > 0 {
+		apiErr.Message = string(body)
+	}
+
+	if apiErr.Message == "" {
+		apiErr.Message = resp.Status
+	}
+
+	return apiErr
+}
+
+// classifyError upgrades the error returned by Client.Do into an
+// *APIError, so JobsService methods can return a value callers check
+// with errors.Is against the sentinels above even though the JSON
+// error-body parsing itself happens outside this chunk, in Client.Do.
+// A *APIError returned by Client.Do already is passed through
+// unchanged.
+func classifyError(resp *http.Response, err error) error {
+	if err == nil || resp == nil {
+		return err
+	}
+
+	if apiErr, ok := err.(*APIError); ok {
+		return apiErr
+	}
+
+	return newAPIError(resp, []byte(err.Error()))
+}
@@ -0,0 +1,134 @@
+package travis
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError_Is(t *testing.T) {
+	tests := []struct {
+		name   string
+		apiErr *APIError
+		target error
+		want   bool
+	}{
+		{
+			name:   "not found by status code",
+			apiErr: &APIError{StatusCode: http.StatusNotFound},
+			target: ErrJobNotFound,
+			want:   true,
+		},
+		{
+			name:   "not cancelable by error type",
+			apiErr: &APIError{ErrorType: "not_cancelable"},
+			target: ErrJobNotCancelable,
+			want:   true,
+		},
+		{
+			name:   "not cancelable by message",
+			apiErr: &APIError{Message: "Job can't be canceled"},
+			target: ErrJobNotCancelable,
+			want:   true,
+		},
+		{
+			name:   "already finished by error type",
+			apiErr: &APIError{ErrorType: "already_finished"},
+			target: ErrJobAlreadyFinished,
+			want:   true,
+		},
+		{
+			name:   "already finished by message",
+			apiErr: &APIError{Message: "job has already finished"},
+			target: ErrJobAlreadyFinished,
+			want:   true,
+		},
+		{
+			name:   "already finished message does not also match not cancelable",
+			apiErr: &APIError{Message: "job has already finished"},
+			target: ErrJobNotCancelable,
+			want:   false,
+		},
+		{
+			name:   "not cancelable message does not also match already finished",
+			apiErr: &APIError{Message: "job can't be canceled"},
+			target: ErrJobAlreadyFinished,
+			want:   false,
+		},
+		{
+			name:   "rate limited",
+			apiErr: &APIError{StatusCode: http.StatusTooManyRequests},
+			target: ErrRateLimited,
+			want:   true,
+		},
+		{
+			name:   "unrelated sentinel does not match",
+			apiErr: &APIError{StatusCode: http.StatusNotFound},
+			target: ErrUnauthorized,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.apiErr, tt.target); got != tt.want {
+				t.Errorf("errors.Is(%+v, %v) = %v, want %v", tt.apiErr, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Header:     http.Header{"X-Request-Id": []string{"abc123"}},
+	}
+
+	apiErr := newAPIError(resp, []byte(`{"error_type":"not_found","error_message":"job not found"}`))
+
+	if apiErr.ErrorType != "not_found" {
+		t.Errorf("unexpected ErrorType: got %s, want not_found", apiErr.ErrorType)
+	}
+
+	if apiErr.Message != "job not found" {
+		t.Errorf("unexpected Message: got %s, want job not found", apiErr.Message)
+	}
+
+	if apiErr.RequestID != "abc123" {
+		t.Errorf("unexpected RequestID: got %s, want abc123", apiErr.RequestID)
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusUnprocessableEntity}
+
+	err := classifyError(resp, errors.New("job can't be canceled"))
+
+	if !errors.Is(err, ErrJobNotCancelable) {
+		t.Errorf("expected classifyError to produce an error matching ErrJobNotCancelable, got: %s", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected classifyError to return an *APIError, got: %T", err)
+	}
+
+	if apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("unexpected StatusCode: got %d, want %d", apiErr.StatusCode, http.StatusUnprocessableEntity)
+	}
+}
+
+func TestClassifyError_PassesThroughExistingAPIError(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusNotFound}
+	original := &APIError{StatusCode: http.StatusNotFound, Message: "job not found"}
+
+	if got := classifyError(resp, original); got != original {
+		t.Errorf("expected classifyError to pass through an existing *APIError unchanged, got: %+v", got)
+	}
+}
+
+func TestClassifyError_NilError(t *testing.T) {
+	if got := classifyError(&http.Response{}, nil); got != nil {
+		t.Errorf("expected classifyError(resp, nil) to return nil, got: %v", got)
+	}
+}
@@ -0,0 +1,113 @@
+package travis
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+// DebugOptions specifies the optional parameters to the
+// JobsService.Debug method.
+type DebugOptions struct {
+	// Quiet suppresses the debug banner Travis normally prints to the
+	// job log when entering debug mode.
+	Quiet bool `json:"quiet"`
+}
+
+// DebugSession describes the interactive SSH session opened by
+// restarting a job in debug mode, parsed from the job log.
+type DebugSession struct {
+	Host      string
+	Port      string
+	User      string
+	Command   string
+	ExpiresAt time.Time
+}
+
+// debugSessionLifetime is how long Travis keeps a debug session's VM
+// alive after it is set up.
+const debugSessionLifetime = 30 * time.Minute
+
+// sshCommandPattern matches the "ssh -p port user@host ..." command
+// Travis prints to the job log once the debug VM is ready.
+var sshCommandPattern = regexp.MustCompile(`ssh -p (\d+) (\S+)@(\S+)[^\r\n]*`)
+
+// parseDebugSession scans a job log for the SSH command Travis prints
+// once a debug session's VM is ready, returning the parsed
+// DebugSession, or ok == false if the log doesn't contain it yet.
+func parseDebugSession(log []byte) (session *DebugSession, ok bool) {
+	match := sshCommandPattern.FindSubmatch(log)
+	if match == nil {
+		return nil, false
+	}
+
+	return &DebugSession{
+		Port:      string(match[1]),
+		User:      string(match[2]),
+		Host:      string(match[3]),
+		Command:   string(match[0]),
+		ExpiresAt: time.Now().Add(debugSessionLifetime),
+	}, true
+}
+
+// Debug restarts the job with the provided id in debug mode, opening an
+// interactive SSH session for live diagnosis. Use DebugInfo to wait for
+// and parse the resulting SSH connection details.
+//
+// Travis CI API docs: http://docs.travis-ci.com/api/#jobs
+func (js *JobsService) Debug(ctx context.Context, id uint, opts *DebugOptions) (*http.Response, error) {
+	if opts == nil {
+		opts = &DebugOptions{}
+	}
+
+	u, err := urlWithOptions(fmt.Sprintf("/job/%d/debug", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := js.client.NewRequest("POST", u, opts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := js.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DebugInfo polls the log of the job with the provided id for the SSH
+// command Travis prints once its debug VM is ready, and returns the
+// parsed DebugSession. Callers should invoke Debug first to put the job
+// into debug mode. ctx.Err() is returned if the SSH command does not
+// appear before ctx is canceled or its deadline is exceeded.
+//
+// Travis CI API docs: http://docs.travis-ci.com/api/#jobs
+func (js *JobsService) DebugInfo(ctx context.Context, id uint) (*DebugSession, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		log, _, err := js.Log(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if session, ok := parseDebugSession(log); ok {
+			return session, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// +build integration
+
+package travis
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestJobsService_Debug(t *testing.T) {
+	t.Parallel()
+
+	jobs, _, err := integrationClient.Jobs.Find(context.TODO(), &JobFindOptions{State: "failed"})
+	if err != nil {
+		t.Fatalf("unexpected error occured: %s", err)
+	}
+
+	if len(jobs) == 0 {
+		t.Fatalf("no failed job available to run this test against")
+	}
+
+	res, err := integrationClient.Jobs.Debug(context.TODO(), jobs[0].Id, &DebugOptions{Quiet: true})
+	if err != nil {
+		t.Fatalf("unexpected error occured: %s", err)
+	}
+
+	if res.StatusCode != http.StatusAccepted {
+		t.Fatalf("invalid http status: %s", res.Status)
+	}
+}
@@ -0,0 +1,43 @@
+package travis
+
+import "testing"
+
+func TestParseDebugSession(t *testing.T) {
+	log := []byte("Setting up debug tools.\r\n" +
+		"Use the following SSH command to access the interactive debug environment:\r\n" +
+		"\r\n" +
+		"ssh -p 42022 travis@42.42.42.42\r\n")
+
+	session, ok := parseDebugSession(log)
+	if !ok {
+		t.Fatalf("expected parseDebugSession to find an SSH command in the log")
+	}
+
+	if session.Port != "42022" {
+		t.Errorf("unexpected Port: got %s, want 42022", session.Port)
+	}
+
+	if session.User != "travis" {
+		t.Errorf("unexpected User: got %s, want travis", session.User)
+	}
+
+	if session.Host != "42.42.42.42" {
+		t.Errorf("unexpected Host: got %s, want 42.42.42.42", session.Host)
+	}
+
+	if session.Command != "ssh -p 42022 travis@42.42.42.42" {
+		t.Errorf("unexpected Command: got %q", session.Command)
+	}
+
+	if session.ExpiresAt.IsZero() {
+		t.Errorf("expected ExpiresAt to be set")
+	}
+}
+
+func TestParseDebugSession_NotYetReady(t *testing.T) {
+	log := []byte("Setting up debug tools.\r\n")
+
+	if _, ok := parseDebugSession(log); ok {
+		t.Errorf("expected parseDebugSession to report not ready before the SSH command appears")
+	}
+}
@@ -0,0 +1,154 @@
+package travis
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// tailPollInterval is how long Tail waits before re-requesting the log
+// and job state when a read returned no new bytes and the job hasn't
+// finished yet.
+const tailPollInterval = 5 * time.Second
+
+// terminalJobStates lists the job states after which no further log
+// output is expected.
+var terminalJobStates = map[string]bool{
+	"passed":   true,
+	"failed":   true,
+	"errored":  true,
+	"canceled": true,
+}
+
+// LogChunk represents a slice of a job's log output read by
+// JobsService.Tail. Offset is the byte offset of Data within the full
+// log. Err is set, and the channel closed, if reading the log failed.
+type LogChunk struct {
+	Data   []byte
+	Offset int
+	Err    error
+}
+
+// Log fetches the full raw log of the job with the provided id.
+//
+// Travis CI API docs: http://docs.travis-ci.com/api/#jobs
+func (js *JobsService) Log(ctx context.Context, id uint) ([]byte, *http.Response, error) {
+	u, err := urlWithOptions(fmt.Sprintf("/jobs/%d/log.txt", id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := js.client.NewRequest("GET", u, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	resp, err := js.client.Do(ctx, req, &buf)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return buf.Bytes(), resp, err
+}
+
+// DeleteLog removes the log of the job with the provided id.
+//
+// Travis CI API docs: http://docs.travis-ci.com/api/#jobs
+func (js *JobsService) DeleteLog(ctx context.Context, id uint) (*http.Response, error) {
+	u, err := urlWithOptions(fmt.Sprintf("/jobs/%d/log", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := js.client.NewRequest("DELETE", u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := js.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// Tail streams the log of the job with the provided id, one chunk at a
+// time, until the job reaches a terminal state and no further bytes are
+// available. It resumes each partial read from the last byte already
+// received via a Range request, and polls the job itself between reads
+// to learn when it has finished. The returned channel is closed once
+// streaming ends, either because the job finished or ctx was canceled;
+// in the latter case the final LogChunk carries ctx.Err().
+//
+// Travis CI API docs: http://docs.travis-ci.com/api/#jobs
+func (js *JobsService) Tail(ctx context.Context, id uint) (<-chan LogChunk, error) {
+	ch := make(chan LogChunk)
+
+	go func() {
+		defer close(ch)
+
+		offset := 0
+
+		for {
+			select {
+			case <-ctx.Done():
+				ch <- LogChunk{Offset: offset, Err: ctx.Err()}
+				return
+			default:
+			}
+
+			u, err := urlWithOptions(fmt.Sprintf("/jobs/%d/log.txt", id), nil)
+			if err != nil {
+				ch <- LogChunk{Offset: offset, Err: err}
+				return
+			}
+
+			headers := map[string]string{
+				"Range": fmt.Sprintf("bytes=%d-", offset),
+			}
+
+			req, err := js.client.NewRequest("GET", u, nil, headers)
+			if err != nil {
+				ch <- LogChunk{Offset: offset, Err: err}
+				return
+			}
+
+			var buf bytes.Buffer
+			resp, err := js.client.Do(ctx, req, &buf)
+			if err != nil {
+				ch <- LogChunk{Offset: offset, Err: err}
+				return
+			}
+
+			if resp.StatusCode != http.StatusRequestedRangeNotSatisfiable && buf.Len() > 0 {
+				ch <- LogChunk{Data: buf.Bytes(), Offset: offset}
+				offset += buf.Len()
+			}
+
+			job, _, err := js.Get(ctx, id)
+			if err != nil {
+				ch <- LogChunk{Offset: offset, Err: err}
+				return
+			}
+
+			if terminalJobStates[job.State] && buf.Len() == 0 {
+				return
+			}
+
+			if buf.Len() == 0 {
+				select {
+				case <-ctx.Done():
+					ch <- LogChunk{Offset: offset, Err: ctx.Err()}
+					return
+				case <-time.After(tailPollInterval):
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
@@ -0,0 +1,70 @@
+// +build integration
+
+package travis
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestJobsService_LogAndDeleteLog(t *testing.T) {
+	t.Parallel()
+
+	jobs, _, err := integrationClient.Jobs.Find(context.TODO(), &JobFindOptions{State: "passed"})
+	if err != nil {
+		t.Fatalf("unexpected error occured: %s", err)
+	}
+
+	if len(jobs) == 0 {
+		t.Fatalf("no passed job available to run this test against")
+	}
+
+	jobId := jobs[0].Id
+
+	log, res, err := integrationClient.Jobs.Log(context.TODO(), jobId)
+	if err != nil {
+		t.Fatalf("unexpected error occured: %s", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("invalid http status: %s", res.Status)
+	}
+
+	if len(log) == 0 {
+		t.Fatalf("expected a non-empty log, got none")
+	}
+
+	res, err = integrationClient.Jobs.DeleteLog(context.TODO(), jobId)
+	if err != nil {
+		t.Fatalf("unexpected error occured: %s", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Fatalf("invalid http status: %s", res.Status)
+	}
+}
+
+func TestJobsService_Tail(t *testing.T) {
+	t.Parallel()
+
+	jobs, _, err := integrationClient.Jobs.Find(context.TODO(), &JobFindOptions{State: "started"})
+	if err != nil {
+		t.Fatalf("unexpected error occured: %s", err)
+	}
+
+	if len(jobs) == 0 {
+		t.Fatalf("no running job available to run this test against")
+	}
+
+	chunks, err := integrationClient.Jobs.Tail(context.TODO(), jobs[0].Id)
+	if err != nil {
+		t.Fatalf("unexpected error occured: %s", err)
+	}
+
+	for chunk := range chunks {
+		if chunk.Err != nil {
+			t.Fatalf("unexpected error occured while tailing: %s", chunk.Err)
+		}
+	}
+}
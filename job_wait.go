@@ -0,0 +1,240 @@
+package travis
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// defaultTerminalJobStates are the job states considered final by Wait
+// and Watch when WaitOptions.TerminalStates is not set.
+var defaultTerminalJobStates = []string{"passed", "failed", "errored", "canceled"}
+
+// Backoff describes an exponential backoff with jitter, used to space
+// out polling requests made by Wait and Watch.
+type Backoff struct {
+	// Initial is the delay before the first retry.
+	Initial time.Duration
+
+	// Max caps the delay between retries, before jitter is applied.
+	// Zero means no cap.
+	Max time.Duration
+
+	// Multiplier is applied to the delay after every retry.
+	Multiplier float64
+
+	// Jitter, between 0 and 1, randomizes the delay by up to that
+	// fraction to avoid thundering-herd polling.
+	Jitter float64
+}
+
+// next returns the delay to use for the given retry attempt, attempt
+// starting at 0.
+func (b Backoff) next(attempt int) time.Duration {
+	delay := float64(b.Initial)
+	for i := 0; i < attempt; i++ {
+		delay *= b.Multiplier
+	}
+
+	if max := float64(b.Max); max > 0 && delay > max {
+		delay = max
+	}
+
+	if b.Jitter > 0 {
+		delay += delay * b.Jitter * (rand.Float64()*2 - 1)
+		if delay < 0 {
+			delay = 0
+		}
+	}
+
+	return time.Duration(delay)
+}
+
+// WaitOptions specifies the optional parameters to the JobsService.Wait
+// and BuildsService.Wait methods.
+type WaitOptions struct {
+	// PollInterval is the delay between polls when Backoff is the zero
+	// value. Defaults to 5 seconds.
+	PollInterval time.Duration
+
+	// Backoff, when set, governs the delay between polls instead of a
+	// fixed PollInterval.
+	Backoff Backoff
+
+	// TerminalStates overrides the set of states that end the wait.
+	// Defaults to passed, failed, errored and canceled.
+	TerminalStates []string
+}
+
+func (opt *WaitOptions) terminalStates() map[string]bool {
+	states := defaultTerminalJobStates
+	if opt != nil && len(opt.TerminalStates) > 0 {
+		states = opt.TerminalStates
+	}
+
+	m := make(map[string]bool, len(states))
+	for _, s := range states {
+		m[s] = true
+	}
+
+	return m
+}
+
+func (opt *WaitOptions) delay(attempt int) time.Duration {
+	if opt != nil && opt.Backoff != (Backoff{}) {
+		return opt.Backoff.next(attempt)
+	}
+
+	if opt != nil && opt.PollInterval > 0 {
+		return opt.PollInterval
+	}
+
+	return 5 * time.Second
+}
+
+// isRetryableStatus reports whether resp represents a transient failure
+// (server error or rate limiting) that should not abort a Wait/Watch
+// loop.
+func isRetryableStatus(resp *http.Response) bool {
+	if resp == nil {
+		return false
+	}
+
+	return resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+}
+
+// JobEvent is sent on the channel returned by Watch every time the
+// observed job is polled. Err is set, and the channel closed, if
+// polling failed non-transiently or ctx was canceled.
+type JobEvent struct {
+	Job *Job
+	Err error
+}
+
+// pollResult is the internal representation shared by JobsService.Watch
+// and BuildsService.Watch, carrying either the polled item and its
+// state or a polling error.
+type pollResult struct {
+	item  interface{}
+	state string
+	err   error
+}
+
+// pollUntilTerminal repeatedly calls fetch on the interval/backoff
+// described by opts, emitting a pollResult after every successful poll,
+// until the reported state is terminal. Transient HTTP 5xx/429
+// responses (per isRetryableStatus) are retried with backoff that grows
+// across consecutive failures, resetting once a poll succeeds. If ctx
+// is canceled or its deadline exceeded, the final pollResult carries
+// ctx.Err(). It powers both JobsService.Watch and BuildsService.Watch.
+func pollUntilTerminal(ctx context.Context, opts *WaitOptions, fetch func(context.Context) (item interface{}, state string, resp *http.Response, err error)) <-chan pollResult {
+	terminalStates := opts.terminalStates()
+	ch := make(chan pollResult)
+
+	go func() {
+		defer close(ch)
+
+		retries := 0
+
+		for {
+			select {
+			case <-ctx.Done():
+				ch <- pollResult{err: ctx.Err()}
+				return
+			default:
+			}
+
+			item, state, resp, err := fetch(ctx)
+			if err != nil {
+				if !isRetryableStatus(resp) {
+					ch <- pollResult{err: err}
+					return
+				}
+
+				select {
+				case <-ctx.Done():
+					ch <- pollResult{err: ctx.Err()}
+					return
+				case <-time.After(opts.delay(retries)):
+				}
+
+				retries++
+				continue
+			}
+
+			retries = 0
+			ch <- pollResult{item: item, state: state}
+
+			if terminalStates[state] {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				ch <- pollResult{err: ctx.Err()}
+				return
+			case <-time.After(opts.delay(0)):
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Wait blocks until the job with the provided id reaches a terminal
+// state, returning the final Job. It polls JobsService.Get on the
+// interval described by opts, tolerating transient HTTP 5xx/429
+// responses. If ctx is canceled or its deadline exceeded, Wait returns
+// ctx.Err() rather than reporting the job as failed.
+//
+// Travis CI API docs: http://docs.travis-ci.com/api/#jobs
+func (js *JobsService) Wait(ctx context.Context, id uint, opts *WaitOptions) (*Job, error) {
+	events, err := js.Watch(ctx, id, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var last *Job
+	for event := range events {
+		if event.Err != nil {
+			return nil, event.Err
+		}
+		last = event.Job
+	}
+
+	return last, nil
+}
+
+// Watch polls the job with the provided id on the interval described by
+// opts, emitting a JobEvent after every poll, and closes the returned
+// channel once the job reaches a terminal state. Transient HTTP 5xx/429
+// responses are retried rather than surfaced as errors. If ctx is
+// canceled or its deadline exceeded, the final JobEvent carries
+// ctx.Err().
+//
+// Travis CI API docs: http://docs.travis-ci.com/api/#jobs
+func (js *JobsService) Watch(ctx context.Context, id uint, opts *WaitOptions) (<-chan JobEvent, error) {
+	results := pollUntilTerminal(ctx, opts, func(ctx context.Context) (interface{}, string, *http.Response, error) {
+		job, resp, err := js.Get(ctx, id)
+		if err != nil {
+			return nil, "", resp, err
+		}
+		return job, job.State, resp, nil
+	})
+
+	ch := make(chan JobEvent)
+
+	go func() {
+		defer close(ch)
+		for result := range results {
+			if result.err != nil {
+				ch <- JobEvent{Err: result.err}
+				continue
+			}
+			ch <- JobEvent{Job: result.item.(*Job)}
+		}
+	}()
+
+	return ch, nil
+}
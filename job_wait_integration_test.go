@@ -0,0 +1,55 @@
+// +build integration
+
+package travis
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestJobsService_Wait(t *testing.T) {
+	t.Parallel()
+
+	jobs, _, err := integrationClient.Jobs.Find(context.TODO(), &JobFindOptions{State: "started"})
+	if err != nil {
+		t.Fatalf("unexpected error occured: %s", err)
+	}
+
+	if len(jobs) == 0 {
+		t.Fatalf("no running job available to run this test against")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	job, err := integrationClient.Jobs.Wait(ctx, jobs[0].Id, &WaitOptions{PollInterval: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("unexpected error occured: %s", err)
+	}
+
+	if !terminalJobStates[job.State] {
+		t.Fatalf("expected job to be in a terminal state, got: %s", job.State)
+	}
+}
+
+func TestJobsService_Wait_ContextCancel(t *testing.T) {
+	t.Parallel()
+
+	jobs, _, err := integrationClient.Jobs.Find(context.TODO(), &JobFindOptions{State: "started"})
+	if err != nil {
+		t.Fatalf("unexpected error occured: %s", err)
+	}
+
+	if len(jobs) == 0 {
+		t.Fatalf("no running job available to run this test against")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = integrationClient.Jobs.Wait(ctx, jobs[0].Id, &WaitOptions{PollInterval: time.Second})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected context.DeadlineExceeded, got: %s", err)
+	}
+}
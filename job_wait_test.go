@@ -0,0 +1,49 @@
+package travis
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPollUntilTerminal_BackoffGrowsAcrossConsecutiveFailures(t *testing.T) {
+	opts := &WaitOptions{
+		Backoff: Backoff{Initial: time.Millisecond, Max: time.Second, Multiplier: 2},
+	}
+
+	var delays []time.Duration
+	var last time.Time
+	failures := 0
+
+	results := pollUntilTerminal(context.Background(), opts, func(ctx context.Context) (interface{}, string, *http.Response, error) {
+		now := time.Now()
+		if !last.IsZero() {
+			delays = append(delays, now.Sub(last))
+		}
+		last = now
+
+		failures++
+		if failures <= 4 {
+			return nil, "", &http.Response{StatusCode: http.StatusInternalServerError}, context.DeadlineExceeded
+		}
+
+		return &Job{State: "passed"}, "passed", nil, nil
+	})
+
+	for result := range results {
+		if result.err != nil {
+			t.Fatalf("unexpected terminal error: %s", result.err)
+		}
+	}
+
+	if len(delays) < 3 {
+		t.Fatalf("expected at least 3 recorded delays between retries, got %d", len(delays))
+	}
+
+	// The bug under test pinned every retry's backoff to opts.Initial;
+	// fixed, the last retry's delay should be well beyond the first.
+	if delays[len(delays)-1] < delays[0]*2 {
+		t.Errorf("expected backoff to grow across consecutive failures instead of staying flat, got delays %v", delays)
+	}
+}
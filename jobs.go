@@ -62,7 +62,10 @@ func (jfo *JobFindOptions) IsValid() bool {
 	return nonZeroValues == 0 || nonZeroValues == 1
 }
 
-// Get fetches job with the provided id.
+// Get fetches job with the provided id. When the API answered with an
+// error, the returned error is an *APIError and can be checked against
+// ErrJobNotFound with errors.Is; errors that occur before a response is
+// received are returned as they are.
 //
 // Travis CI API docs: http://docs.travis-ci.com/api/#jobs
 func (js *JobsService) Get(ctx context.Context, id uint) (*Job, *http.Response, error) {
@@ -79,7 +82,7 @@ func (js *JobsService) Get(ctx context.Context, id uint) (*Job, *http.Response,
 	var jobResp getJobResponse
 	resp, err := js.client.Do(ctx, req, &jobResp)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, classifyError(resp, err)
 	}
 
 	return &jobResp.Job, resp, err
@@ -139,7 +142,10 @@ func (js *JobsService) Find(ctx context.Context, opt *JobFindOptions) ([]Job, *h
 	return jobsResp.Jobs, resp, err
 }
 
-// Cancel job with the provided id.
+// Cancel job with the provided id. When the API answered with an
+// error, the returned error is an *APIError and can be checked against
+// ErrJobNotCancelable or ErrJobAlreadyFinished with errors.Is; errors
+// that occur before a response is received are returned as they are.
 //
 // Travis CI API docs: http://docs.travis-ci.com/api/#jobs
 func (js *JobsService) Cancel(ctx context.Context, id uint) (*http.Response, error) {
@@ -155,13 +161,16 @@ func (js *JobsService) Cancel(ctx context.Context, id uint) (*http.Response, err
 
 	resp, err := js.client.Do(ctx, req, nil)
 	if err != nil {
-		return resp, err
+		return resp, classifyError(resp, err)
 	}
 
 	return resp, err
 }
 
-// Restart job with the provided id.
+// Restart job with the provided id. When the API answered with an
+// error, the returned error is an *APIError and can be checked against
+// ErrJobAlreadyFinished with errors.Is; errors that occur before a
+// response is received are returned as they are.
 //
 // Travis CI API docs: http://docs.travis-ci.com/api/#jobs
 func (js *JobsService) Restart(ctx context.Context, id uint) (*http.Response, error) {
@@ -177,7 +186,7 @@ func (js *JobsService) Restart(ctx context.Context, id uint) (*http.Response, er
 
 	resp, err := js.client.Do(ctx, req, nil)
 	if err != nil {
-		return resp, err
+		return resp, classifyError(resp, err)
 	}
 
 	return resp, err